@@ -0,0 +1,73 @@
+package mon
+
+import (
+	"sync"
+
+	"github.com/ethereum-optimism/optimism/op-challenger/game/types"
+)
+
+// dispatchGames runs detect/forecast for each game independently through
+// runGame, which gates on the monitor's shared worker pool and rate
+// limiter so a tick with many games can't stall behind a single slow game
+// or overwhelm the L1 endpoint. It blocks until every game has either
+// completed or been dropped.
+func (m *gameMonitor) dispatchGames(games []types.GameMetadata) {
+	m.metrics.RecordGamesQueued(len(games))
+
+	var wg sync.WaitGroup
+	for _, game := range games {
+		select {
+		case <-m.ctx.Done():
+			m.metrics.RecordGameDropped()
+			continue
+		default:
+		}
+
+		wg.Add(1)
+		go func(game types.GameMetadata) {
+			defer wg.Done()
+			m.runGame(game)
+		}(game)
+	}
+	wg.Wait()
+}
+
+// runGame acquires a slot in the monitor's bounded worker pool (shared
+// with onNewGame, so subscription-driven games can't bypass the
+// concurrency cap) and a rate limiter permit, then runs detect/forecast
+// for a single game.
+func (m *gameMonitor) runGame(game types.GameMetadata) {
+	select {
+	case m.gameSem <- struct{}{}:
+	case <-m.ctx.Done():
+		m.metrics.RecordGameDropped()
+		return
+	}
+	defer func() { <-m.gameSem }()
+
+	if m.ctx.Err() != nil {
+		m.metrics.RecordGameDropped()
+		return
+	}
+
+	if m.limiter != nil {
+		if err := m.limiter.Wait(m.ctx); err != nil {
+			m.metrics.RecordGameDropped()
+			return
+		}
+	}
+
+	m.metrics.IncGamesInFlight()
+	defer m.metrics.DecGamesInFlight()
+
+	games := []types.GameMetadata{game}
+	detectStart := m.clock.Now()
+	m.detect(m.ctx, games)
+	m.metrics.RecordDetectDuration(m.clock.Now().Sub(detectStart))
+
+	forecastStart := m.clock.Now()
+	m.forecast(m.ctx, games)
+	m.metrics.RecordForecastDuration(m.clock.Now().Sub(forecastStart))
+
+	m.metrics.RecordGameCompleted()
+}
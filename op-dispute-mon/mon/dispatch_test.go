@@ -0,0 +1,160 @@
+package mon
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ethereum-optimism/optimism/op-challenger/game/types"
+	"github.com/ethereum-optimism/optimism/op-service/clock"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestMonitorForDispatch builds a gameMonitor with the given concurrency
+// and detect callback wired in, for exercising dispatchGames/runGame/
+// onNewGame in isolation. forecast is a no-op.
+func newTestMonitorForDispatch(t *testing.T, concurrency int, detect Detect) *gameMonitor {
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	return newGameMonitor(
+		ctx,
+		log.NewLogger(log.DiscardHandler()),
+		clock.SystemClock,
+		nil,
+		time.Second,
+		0,
+		0,
+		0,
+		MonitorModePollingOnly,
+		nil,
+		concurrency,
+		0,
+		0,
+		detect,
+		func(ctx context.Context, games []types.GameMetadata) {},
+		nil,
+		nil,
+		nil,
+		nil,
+	)
+}
+
+// blockingCounter tracks how many callers are concurrently past the gate
+// and the maximum concurrency observed, unblocking each caller only once
+// release is closed.
+type blockingCounter struct {
+	mu      sync.Mutex
+	current int
+	max     int
+	release chan struct{}
+}
+
+func newBlockingCounter() *blockingCounter {
+	return &blockingCounter{release: make(chan struct{})}
+}
+
+func (b *blockingCounter) enter() {
+	b.mu.Lock()
+	b.current++
+	if b.current > b.max {
+		b.max = b.current
+	}
+	b.mu.Unlock()
+
+	<-b.release
+
+	b.mu.Lock()
+	b.current--
+	b.mu.Unlock()
+}
+
+func (b *blockingCounter) observed() int {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.current
+}
+
+func (b *blockingCounter) maxObserved() int {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.max
+}
+
+func TestDispatchGames_BoundsConcurrency(t *testing.T) {
+	const concurrency = 2
+	const gameCount = 6
+
+	counter := newBlockingCounter()
+	detect := func(ctx context.Context, games []types.GameMetadata) { counter.enter() }
+
+	m := newTestMonitorForDispatch(t, concurrency, detect)
+
+	games := make([]types.GameMetadata, gameCount)
+	done := make(chan struct{})
+	go func() {
+		m.dispatchGames(games)
+		close(done)
+	}()
+
+	require.Eventually(t, func() bool {
+		return counter.observed() == concurrency
+	}, time.Second, time.Millisecond)
+	require.LessOrEqual(t, counter.maxObserved(), concurrency)
+
+	close(counter.release)
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("dispatchGames did not finish after release")
+	}
+	require.Equal(t, concurrency, counter.maxObserved())
+}
+
+func TestOnNewGame_SharesDispatchConcurrencyBound(t *testing.T) {
+	const concurrency = 2
+
+	counter := newBlockingCounter()
+	detect := func(ctx context.Context, games []types.GameMetadata) { counter.enter() }
+
+	m := newTestMonitorForDispatch(t, concurrency, detect)
+
+	for i := 0; i < concurrency+3; i++ {
+		m.onNewGame(types.GameMetadata{})
+	}
+
+	require.Eventually(t, func() bool {
+		return counter.observed() == concurrency
+	}, time.Second, time.Millisecond)
+	require.LessOrEqual(t, counter.maxObserved(), concurrency, "onNewGame must respect the same worker-pool bound as dispatchGames")
+
+	close(counter.release)
+	require.Eventually(t, func() bool {
+		return counter.observed() == 0
+	}, time.Second, time.Millisecond)
+}
+
+func TestStartMonitoring_DoesNotRestartLimiterOnRepeatedCalls(t *testing.T) {
+	m := newTestMonitorForDispatch(t, 1, func(ctx context.Context, games []types.GameMetadata) {})
+	m.limiter = NewRateLimiter(1e6, 1)
+
+	m.StartMonitoring()
+	ctxAfterFirstStart := m.ctx
+	cancelAfterFirstStart := m.cancel
+
+	// A second call must be a no-op with respect to both the cancellation
+	// setup and the limiter: StartMonitoring is documented to tolerate
+	// being called multiple times, and starting a second limiter loop
+	// would silently double the effective rate limit.
+	m.StartMonitoring()
+	require.Equal(t, ctxAfterFirstStart, m.ctx)
+	require.NotNil(t, cancelAfterFirstStart)
+
+	m.StopMonitoring()
+
+	// If a second limiter loop were still running, permits would still be
+	// granted after Stop closed the first loop's done channel.
+	err := m.limiter.Wait(context.Background())
+	require.ErrorIs(t, err, ErrRateLimiterStopped)
+}
@@ -0,0 +1,41 @@
+package mon
+
+import (
+	"context"
+
+	"github.com/ethereum-optimism/optimism/op-challenger/game/types"
+
+	"github.com/ethereum/go-ethereum"
+)
+
+// GameEventSubscriber subscribes to on-chain DisputeGameCreated events so new
+// games can be detected and forecast as soon as their creation log arrives,
+// instead of waiting for the next polling tick.
+type GameEventSubscriber interface {
+	// SubscribeNewGames subscribes to DisputeGameCreated events on the
+	// dispute game factory, writing each newly observed game to sink as its
+	// creation log is processed. The returned subscription's error channel
+	// is used to detect drops so the monitor can resubscribe.
+	SubscribeNewGames(ctx context.Context, sink chan<- types.GameMetadata) (ethereum.Subscription, error)
+}
+
+// MonitorMode controls how the gameMonitor discovers newly created games.
+type MonitorMode uint8
+
+const (
+	// MonitorModePollingOnly never subscribes to events; games are only
+	// discovered on the monitorInterval ticker. This is the original
+	// behaviour and remains the safety net in the other modes.
+	MonitorModePollingOnly MonitorMode = iota
+	// MonitorModeSubscriptionFirst drives detect/forecast primarily off of
+	// subscription events: while the subscription is healthy, ticker ticks
+	// are skipped, and the ticker only resumes driving full-window scans
+	// once the subscription is gone, to cover subscription gaps or
+	// reconnects.
+	MonitorModeSubscriptionFirst
+	// MonitorModeHybrid runs both discovery paths at full cadence: the
+	// ticker always reconciles the full window on every tick in addition
+	// to whatever the subscription delivers, rather than backing off while
+	// the subscription is healthy.
+	MonitorModeHybrid
+)
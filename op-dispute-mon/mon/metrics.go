@@ -0,0 +1,282 @@
+package mon
+
+import (
+	"time"
+
+	"github.com/ethereum-optimism/optimism/op-service/metrics"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// MetricsNamespace is the Prometheus namespace every gameMonitor metric is
+// registered under.
+const MetricsNamespace = "op_dispute_mon_monitor"
+
+// MonitorMetricer is the subset of metrics recorded by the gameMonitor
+// around each call to monitorGames. Implementations are expected to back
+// these onto Prometheus-style counters, gauges and histograms registered
+// once at construction time, and to tear them down again via Unregister
+// when the monitor stops.
+type MonitorMetricer interface {
+	// IncMonitorIterations increments the count of monitorGames ticks,
+	// successful or not.
+	IncMonitorIterations()
+
+	// RecordMonitorDuration records the total wall-clock time spent in a
+	// single call to monitorGames, successful or not.
+	RecordMonitorDuration(dur time.Duration)
+
+	// RecordGamesObserved records the number of games returned by the
+	// factory fetch on a single tick.
+	RecordGamesObserved(count int)
+
+	// RecordBlockNumberFetchError increments the iteration error count for
+	// the fetch-block-number phase.
+	RecordBlockNumberFetchError()
+	// RecordBlockHashFetchError increments the iteration error count for
+	// the fetch-block-hash phase.
+	RecordBlockHashFetchError()
+	// RecordGamesFetchError increments the iteration error count for the
+	// fetch-games phase.
+	RecordGamesFetchError()
+
+	// RecordReorgDetected increments the count of L1 reorgs observed at the
+	// monitor's scan depth.
+	RecordReorgDetected()
+
+	// RecordGamesQueued records how many games were queued for per-game
+	// detect/forecast dispatch on a single tick.
+	RecordGamesQueued(count int)
+	// IncGamesInFlight and DecGamesInFlight track how many games are
+	// currently being processed by the worker pool.
+	IncGamesInFlight()
+	DecGamesInFlight()
+	// RecordGameCompleted increments the count of games that finished
+	// detect/forecast processing.
+	RecordGameCompleted()
+	// RecordGameDropped increments the count of games that were not
+	// processed because the monitor was shutting down.
+	RecordGameDropped()
+
+	// RecordBlockFetchDuration records the time spent fetching the latest
+	// block number and hash.
+	RecordBlockFetchDuration(dur time.Duration)
+	// RecordFactoryFetchDuration records the time spent loading games from
+	// the dispute game factory.
+	RecordFactoryFetchDuration(dur time.Duration)
+	// RecordDetectDuration records the time spent in the detect callback.
+	RecordDetectDuration(dur time.Duration)
+	// RecordForecastDuration records the time spent in the forecast
+	// callback.
+	RecordForecastDuration(dur time.Duration)
+
+	// Unregister removes all metrics owned by this metricer. It is called
+	// once the monitor stops so a fresh metricer can be registered on the
+	// next start without colliding with stale series.
+	Unregister()
+}
+
+// NoopMetrics is a MonitorMetricer that discards every recording. It's used
+// when metrics collection is disabled so the monitor doesn't need to branch
+// on whether a registry is present.
+var NoopMetrics MonitorMetricer = new(noopMetricer)
+
+type noopMetricer struct{}
+
+func (*noopMetricer) IncMonitorIterations()                    {}
+func (*noopMetricer) RecordMonitorDuration(time.Duration)      {}
+func (*noopMetricer) RecordGamesObserved(int)                  {}
+func (*noopMetricer) RecordBlockNumberFetchError()             {}
+func (*noopMetricer) RecordBlockHashFetchError()               {}
+func (*noopMetricer) RecordGamesFetchError()                   {}
+func (*noopMetricer) RecordReorgDetected()                     {}
+func (*noopMetricer) RecordGamesQueued(int)                    {}
+func (*noopMetricer) IncGamesInFlight()                        {}
+func (*noopMetricer) DecGamesInFlight()                        {}
+func (*noopMetricer) RecordGameCompleted()                     {}
+func (*noopMetricer) RecordGameDropped()                       {}
+func (*noopMetricer) RecordBlockFetchDuration(time.Duration)   {}
+func (*noopMetricer) RecordFactoryFetchDuration(time.Duration) {}
+func (*noopMetricer) RecordDetectDuration(time.Duration)       {}
+func (*noopMetricer) RecordForecastDuration(time.Duration)     {}
+func (*noopMetricer) Unregister()                              {}
+
+// Metrics is the concrete MonitorMetricer backing every recorded metric
+// with a Prometheus counter, gauge or histogram, all registered once at
+// construction time: the "metricsManager" style used elsewhere in EVM
+// tooling, where a single struct owns every named metric updated around
+// each stage of monitorGames().
+type Metrics struct {
+	registry *prometheus.Registry
+
+	iterations      prometheus.Counter
+	iterationErrors *prometheus.CounterVec
+	gamesObserved   prometheus.Histogram
+	reorgsDetected  prometheus.Counter
+
+	gamesQueued    prometheus.Histogram
+	gamesInFlight  prometheus.Gauge
+	gamesCompleted prometheus.Counter
+	gamesDropped   prometheus.Counter
+
+	monitorDuration  prometheus.Histogram
+	blockFetchTime   prometheus.Histogram
+	factoryFetchTime prometheus.Histogram
+	detectTime       prometheus.Histogram
+	forecastTime     prometheus.Histogram
+}
+
+var _ MonitorMetricer = (*Metrics)(nil)
+
+// NewMetrics creates a Metrics backed by a fresh Prometheus registry,
+// registering every gameMonitor metric up front so operators can alert on
+// stalled polling loops, forecast latency regressions, or spikes in
+// fault-game counts without scraping logs.
+func NewMetrics() *Metrics {
+	registry := metrics.NewRegistry()
+	factory := metrics.With(registry)
+
+	return &Metrics{
+		registry: registry,
+
+		iterations: factory.NewCounter(prometheus.CounterOpts{
+			Namespace: MetricsNamespace,
+			Name:      "iterations_total",
+			Help:      "Number of monitorGames iterations run, successful or not.",
+		}),
+		iterationErrors: factory.NewCounterVec(prometheus.CounterOpts{
+			Namespace: MetricsNamespace,
+			Name:      "iteration_errors_total",
+			Help:      "Number of monitorGames iteration errors, by phase.",
+		}, []string{"phase"}),
+		gamesObserved: factory.NewHistogram(prometheus.HistogramOpts{
+			Namespace: MetricsNamespace,
+			Name:      "games_observed",
+			Help:      "Number of games returned by the factory fetch on a single tick.",
+			Buckets:   prometheus.ExponentialBuckets(1, 2, 10),
+		}),
+		reorgsDetected: factory.NewCounter(prometheus.CounterOpts{
+			Namespace: MetricsNamespace,
+			Name:      "reorgs_detected_total",
+			Help:      "Number of L1 reorgs observed at the monitor's scan depth.",
+		}),
+
+		gamesQueued: factory.NewHistogram(prometheus.HistogramOpts{
+			Namespace: MetricsNamespace,
+			Name:      "games_queued",
+			Help:      "Number of games queued for per-game detect/forecast dispatch on a single tick.",
+			Buckets:   prometheus.ExponentialBuckets(1, 2, 10),
+		}),
+		gamesInFlight: factory.NewGauge(prometheus.GaugeOpts{
+			Namespace: MetricsNamespace,
+			Name:      "games_in_flight",
+			Help:      "Number of games currently being processed by the worker pool.",
+		}),
+		gamesCompleted: factory.NewCounter(prometheus.CounterOpts{
+			Namespace: MetricsNamespace,
+			Name:      "games_completed_total",
+			Help:      "Number of games that finished detect/forecast processing.",
+		}),
+		gamesDropped: factory.NewCounter(prometheus.CounterOpts{
+			Namespace: MetricsNamespace,
+			Name:      "games_dropped_total",
+			Help:      "Number of games not processed because the monitor was shutting down.",
+		}),
+
+		monitorDuration: factory.NewHistogram(prometheus.HistogramOpts{
+			Namespace: MetricsNamespace,
+			Name:      "iteration_duration_seconds",
+			Help:      "Total wall-clock time spent in a single monitorGames call.",
+			Buckets:   prometheus.DefBuckets,
+		}),
+		blockFetchTime: factory.NewHistogram(prometheus.HistogramOpts{
+			Namespace: MetricsNamespace,
+			Name:      "block_fetch_duration_seconds",
+			Help:      "Time spent fetching the latest block number and hash.",
+			Buckets:   prometheus.DefBuckets,
+		}),
+		factoryFetchTime: factory.NewHistogram(prometheus.HistogramOpts{
+			Namespace: MetricsNamespace,
+			Name:      "factory_fetch_duration_seconds",
+			Help:      "Time spent loading games from the dispute game factory.",
+			Buckets:   prometheus.DefBuckets,
+		}),
+		detectTime: factory.NewHistogram(prometheus.HistogramOpts{
+			Namespace: MetricsNamespace,
+			Name:      "detect_duration_seconds",
+			Help:      "Time spent in the detect callback.",
+			Buckets:   prometheus.DefBuckets,
+		}),
+		forecastTime: factory.NewHistogram(prometheus.HistogramOpts{
+			Namespace: MetricsNamespace,
+			Name:      "forecast_duration_seconds",
+			Help:      "Time spent in the forecast callback.",
+			Buckets:   prometheus.DefBuckets,
+		}),
+	}
+}
+
+func (m *Metrics) IncMonitorIterations() { m.iterations.Inc() }
+
+func (m *Metrics) RecordMonitorDuration(dur time.Duration) {
+	m.monitorDuration.Observe(dur.Seconds())
+}
+
+func (m *Metrics) RecordGamesObserved(count int) { m.gamesObserved.Observe(float64(count)) }
+
+func (m *Metrics) RecordBlockNumberFetchError() {
+	m.iterationErrors.WithLabelValues("fetch-block-number").Inc()
+}
+
+func (m *Metrics) RecordBlockHashFetchError() {
+	m.iterationErrors.WithLabelValues("fetch-block-hash").Inc()
+}
+
+func (m *Metrics) RecordGamesFetchError() {
+	m.iterationErrors.WithLabelValues("fetch-games").Inc()
+}
+
+func (m *Metrics) RecordReorgDetected() { m.reorgsDetected.Inc() }
+
+func (m *Metrics) RecordGamesQueued(count int) { m.gamesQueued.Observe(float64(count)) }
+func (m *Metrics) IncGamesInFlight()           { m.gamesInFlight.Inc() }
+func (m *Metrics) DecGamesInFlight()           { m.gamesInFlight.Dec() }
+func (m *Metrics) RecordGameCompleted()        { m.gamesCompleted.Inc() }
+func (m *Metrics) RecordGameDropped()          { m.gamesDropped.Inc() }
+
+func (m *Metrics) RecordBlockFetchDuration(dur time.Duration) {
+	m.blockFetchTime.Observe(dur.Seconds())
+}
+
+func (m *Metrics) RecordFactoryFetchDuration(dur time.Duration) {
+	m.factoryFetchTime.Observe(dur.Seconds())
+}
+
+func (m *Metrics) RecordDetectDuration(dur time.Duration) { m.detectTime.Observe(dur.Seconds()) }
+
+func (m *Metrics) RecordForecastDuration(dur time.Duration) {
+	m.forecastTime.Observe(dur.Seconds())
+}
+
+// Unregister removes every collector owned by this Metrics from its
+// registry, so a fresh Metrics can be registered on the next
+// StartMonitoring without colliding with stale series.
+func (m *Metrics) Unregister() {
+	collectors := []prometheus.Collector{
+		m.iterations,
+		m.iterationErrors,
+		m.gamesObserved,
+		m.reorgsDetected,
+		m.gamesQueued,
+		m.gamesInFlight,
+		m.gamesCompleted,
+		m.gamesDropped,
+		m.monitorDuration,
+		m.blockFetchTime,
+		m.factoryFetchTime,
+		m.detectTime,
+		m.forecastTime,
+	}
+	for _, c := range collectors {
+		m.registry.Unregister(c)
+	}
+}
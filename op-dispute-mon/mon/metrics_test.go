@@ -0,0 +1,156 @@
+package mon
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ethereum-optimism/optimism/op-challenger/game/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+// spyMetrics is a MonitorMetricer test double that records iterations,
+// monitor durations, per-phase iteration errors, reorgs and dropped games,
+// so tests can assert the instrumentation actually fires from
+// monitorGames/dispatch.go rather than just compiling against the
+// interface. Every other call falls through to the embedded noopMetricer.
+type spyMetrics struct {
+	noopMetricer
+
+	mu                     sync.Mutex
+	iterations             int
+	iterationErrorsByPhase map[string]int
+	monitorDurations       int
+	reorgsDetected         int
+	gamesDropped           int
+}
+
+func newSpyMetrics() *spyMetrics {
+	return &spyMetrics{iterationErrorsByPhase: make(map[string]int)}
+}
+
+func (s *spyMetrics) IncMonitorIterations() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.iterations++
+}
+
+func (s *spyMetrics) RecordMonitorDuration(time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.monitorDurations++
+}
+
+func (s *spyMetrics) RecordBlockNumberFetchError() { s.recordError("fetch-block-number") }
+func (s *spyMetrics) RecordBlockHashFetchError()   { s.recordError("fetch-block-hash") }
+func (s *spyMetrics) RecordGamesFetchError()       { s.recordError("fetch-games") }
+
+func (s *spyMetrics) recordError(phase string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.iterationErrorsByPhase[phase]++
+}
+
+func (s *spyMetrics) RecordReorgDetected() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.reorgsDetected++
+}
+
+func (s *spyMetrics) RecordGameDropped() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.gamesDropped++
+}
+
+func (s *spyMetrics) errorCount(phase string) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.iterationErrorsByPhase[phase]
+}
+
+func (s *spyMetrics) iterationCount() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.iterations
+}
+
+func (s *spyMetrics) monitorDurationCount() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.monitorDurations
+}
+
+func (s *spyMetrics) reorgCount() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.reorgsDetected
+}
+
+func (s *spyMetrics) droppedCount() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.gamesDropped
+}
+
+func TestMonitorGames_RecordsIterationAndBlockNumberFetchError(t *testing.T) {
+	spy := newSpyMetrics()
+	fetchBlockNumber := func(ctx context.Context) (uint64, error) {
+		return 0, errors.New("rpc down")
+	}
+
+	m := newTestMonitorForScan(t, nil, nil, nil)
+	m.metrics = spy
+	m.fetchBlockNumber = fetchBlockNumber
+
+	err := m.monitorGames()
+	require.Error(t, err)
+
+	require.Equal(t, 1, spy.iterationCount())
+	require.Equal(t, 1, spy.errorCount("fetch-block-number"))
+	require.Equal(t, 1, spy.monitorDurationCount())
+}
+
+func TestFetchGamesForScan_RecordsReorgDetected(t *testing.T) {
+	spy := newSpyMetrics()
+	fetchGames := func(ctx context.Context, blockHash common.Hash, earliestTimestamp uint64) ([]types.GameMetadata, error) {
+		return nil, nil
+	}
+	fetchGamesRange := func(ctx context.Context, from, to common.Hash, earliestTimestamp uint64) ([]types.GameMetadata, error) {
+		return nil, nil
+	}
+	newPriorHash := common.HexToHash("0xdd")
+	fetchBlockHash := func(ctx context.Context, number *big.Int) (common.Hash, error) {
+		return newPriorHash, nil
+	}
+
+	m := newTestMonitorForScan(t, fetchGames, fetchGamesRange, fetchBlockHash)
+	m.metrics = spy
+	m.haveLastScanned = true
+	m.lastBlockNumber = 9
+	m.lastBlockHash = common.HexToHash("0xaa")
+
+	_, err := m.fetchGamesForScan(10, common.HexToHash("0xbb"))
+	require.NoError(t, err)
+	require.Equal(t, 1, spy.reorgCount())
+}
+
+func TestRunGame_RecordsGameDroppedOnCancelledContext(t *testing.T) {
+	spy := newSpyMetrics()
+	ranDetect := false
+	m := newTestMonitorForDispatch(t, 1, func(ctx context.Context, games []types.GameMetadata) { ranDetect = true })
+	m.metrics = spy
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	m.ctx = ctx
+
+	m.runGame(types.GameMetadata{})
+
+	require.False(t, ranDetect)
+	require.Equal(t, 1, spy.droppedCount())
+}
@@ -9,15 +9,31 @@ import (
 	"github.com/ethereum-optimism/optimism/op-challenger/game/types"
 	"github.com/ethereum-optimism/optimism/op-service/clock"
 
+	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/event"
 	"github.com/ethereum/go-ethereum/log"
 )
 
+// gameSubscriptionBackoff bounds how fast the monitor retries a dropped
+// game event subscription.
+const gameSubscriptionBackoff = 1 * time.Second
+
+// newGamesBufferSize bounds how many pending creation events can queue up
+// behind a slow detect/forecast call before the subscriber blocks.
+const newGamesBufferSize = 100
+
+// defaultConcurrency is used when a monitor is constructed with a
+// non-positive concurrency, preserving the historical fully-serial
+// behaviour rather than panicking on a zero-sized worker pool.
+const defaultConcurrency = 1
+
 type Detect func(ctx context.Context, games []types.GameMetadata)
 type Forecast func(ctx context.Context, games []types.GameMetadata)
 type BlockHashFetcher func(ctx context.Context, number *big.Int) (common.Hash, error)
 type BlockNumberFetcher func(ctx context.Context) (uint64, error)
 type FactoryGameFetcher func(ctx context.Context, blockHash common.Hash, earliestTimestamp uint64) ([]types.GameMetadata, error)
+type FactoryGameRangeFetcher func(ctx context.Context, fromBlockHash common.Hash, toBlockHash common.Hash, earliestTimestamp uint64) ([]types.GameMetadata, error)
 
 type gameMonitor struct {
 	logger log.Logger
@@ -30,9 +46,35 @@ type gameMonitor struct {
 	gameWindow      time.Duration
 	monitorInterval time.Duration
 
+	// confirmations is how far behind the chain tip the monitor scans, to
+	// avoid acting on blocks that are still likely to be reorged out.
+	confirmations uint64
+	// reorgRescanDepth is how far to rewind and reprocess when a reorg is
+	// detected at the previously-scanned height.
+	reorgRescanDepth uint64
+
+	haveLastScanned bool
+	lastBlockNumber uint64
+	lastBlockHash   common.Hash
+
+	metrics MonitorMetricer
+
+	mode           MonitorMode
+	gameSubscriber GameEventSubscriber
+	newGames       chan types.GameMetadata
+
+	// concurrency bounds how many games are dispatched to detect/forecast
+	// at once; gameSem is the persistent semaphore enforcing that bound
+	// across both ticker-driven and subscription-driven games. limiter
+	// additionally caps the rate of per-game RPC calls.
+	concurrency int
+	gameSem     chan struct{}
+	limiter     *RateLimiter
+
 	detect           Detect
 	forecast         Forecast
 	fetchGames       FactoryGameFetcher
+	fetchGamesRange  FactoryGameRangeFetcher
 	fetchBlockHash   BlockHashFetcher
 	fetchBlockNumber BlockNumberFetcher
 }
@@ -41,29 +83,77 @@ func newGameMonitor(
 	ctx context.Context,
 	logger log.Logger,
 	cl clock.Clock,
+	metrics MonitorMetricer,
 	monitorInterval time.Duration,
 	gameWindow time.Duration,
+	confirmations uint64,
+	reorgRescanDepth uint64,
+	mode MonitorMode,
+	gameSubscriber GameEventSubscriber,
+	concurrency int,
+	rateLimitOpsPerSec float64,
+	rateLimitBurst int,
 	detect Detect,
 	forecast Forecast,
 	factory FactoryGameFetcher,
+	factoryRange FactoryGameRangeFetcher,
 	fetchBlockNumber BlockNumberFetcher,
 	fetchBlockHash BlockHashFetcher,
 ) *gameMonitor {
+	if metrics == nil {
+		metrics = NoopMetrics
+	}
+	if concurrency <= 0 {
+		concurrency = defaultConcurrency
+	}
+	var limiter *RateLimiter
+	if rateLimitOpsPerSec > 0 {
+		limiter = NewRateLimiter(rateLimitOpsPerSec, rateLimitBurst)
+	}
 	return &gameMonitor{
 		logger:           logger,
 		clock:            cl,
 		ctx:              ctx,
 		done:             make(chan struct{}),
+		metrics:          metrics,
 		monitorInterval:  monitorInterval,
 		gameWindow:       gameWindow,
+		confirmations:    confirmations,
+		reorgRescanDepth: reorgRescanDepth,
+		mode:             mode,
+		gameSubscriber:   gameSubscriber,
+		newGames:         make(chan types.GameMetadata, newGamesBufferSize),
+		concurrency:      concurrency,
+		gameSem:          make(chan struct{}, concurrency),
+		limiter:          limiter,
 		detect:           detect,
 		forecast:         forecast,
 		fetchGames:       factory,
+		fetchGamesRange:  factoryRange,
 		fetchBlockNumber: fetchBlockNumber,
 		fetchBlockHash:   fetchBlockHash,
 	}
 }
 
+// scanBlockNumber returns the block number the monitor should scan at,
+// which trails the chain tip by confirmations to avoid acting on blocks
+// that are still likely to be reorged out.
+func (m *gameMonitor) scanBlockNumber(head uint64) uint64 {
+	if head < m.confirmations {
+		return 0
+	}
+	return head - m.confirmations
+}
+
+// reorgRescanFrom returns the block number to rewind to and reprocess from
+// once a reorg is detected at lastScanned.
+func (m *gameMonitor) reorgRescanFrom(lastScanned uint64) uint64 {
+	if lastScanned < m.reorgRescanDepth {
+		return 0
+	}
+	return lastScanned - m.reorgRescanDepth
+}
+
 func (m *gameMonitor) minGameTimestamp() uint64 {
 	if m.gameWindow.Seconds() == 0 {
 		return 0
@@ -77,33 +167,139 @@ func (m *gameMonitor) minGameTimestamp() uint64 {
 }
 
 func (m *gameMonitor) monitorGames() error {
-	blockNumber, err := m.fetchBlockNumber(m.ctx)
+	m.metrics.IncMonitorIterations()
+	start := m.clock.Now()
+	defer func() {
+		m.metrics.RecordMonitorDuration(m.clock.Now().Sub(start))
+	}()
+
+	blockFetchStart := m.clock.Now()
+	head, err := m.fetchBlockNumber(m.ctx)
 	if err != nil {
+		m.metrics.RecordBlockNumberFetchError()
 		return fmt.Errorf("Failed to fetch block number: %w", err)
 	}
-	m.logger.Debug("Fetched block number", "blockNumber", blockNumber)
+	blockNumber := m.scanBlockNumber(head)
+	m.logger.Debug("Fetched block number", "head", head, "blockNumber", blockNumber)
 	blockHash, err := m.fetchBlockHash(context.Background(), new(big.Int).SetUint64(blockNumber))
 	if err != nil {
+		m.metrics.RecordBlockHashFetchError()
 		return fmt.Errorf("Failed to fetch block hash: %w", err)
 	}
-	games, err := m.fetchGames(m.ctx, blockHash, m.minGameTimestamp())
+	m.metrics.RecordBlockFetchDuration(m.clock.Now().Sub(blockFetchStart))
+
+	if m.haveLastScanned && blockNumber == m.lastBlockNumber && blockHash == m.lastBlockHash {
+		m.logger.Debug("Head has not advanced, skipping scan", "blockNumber", blockNumber)
+		return nil
+	}
+
+	factoryFetchStart := m.clock.Now()
+	games, err := m.fetchGamesForScan(blockNumber, blockHash)
 	if err != nil {
+		m.metrics.RecordGamesFetchError()
 		return fmt.Errorf("failed to load games: %w", err)
 	}
-	m.detect(m.ctx, games)
-	m.forecast(m.ctx, games)
+	m.metrics.RecordFactoryFetchDuration(m.clock.Now().Sub(factoryFetchStart))
+	m.metrics.RecordGamesObserved(len(games))
+
+	m.lastBlockNumber = blockNumber
+	m.lastBlockHash = blockHash
+	m.haveLastScanned = true
+
+	m.dispatchGames(games)
 	return nil
 }
 
+// fetchGamesForScan loads the games to run detect/forecast over for the
+// current tick. On the first tick, or on any normal advance of the chain
+// head, the full gameWindow is re-fetched so games already inside the
+// window keep getting detect/forecast as their claims progress. Only once
+// a reorg is detected at the previously-scanned height is fetchGamesRange
+// used, to reprocess just the affected range instead of the full window.
+func (m *gameMonitor) fetchGamesForScan(blockNumber uint64, blockHash common.Hash) ([]types.GameMetadata, error) {
+	earliestTimestamp := m.minGameTimestamp()
+	if !m.haveLastScanned || m.fetchGamesRange == nil {
+		return m.fetchGames(m.ctx, blockHash, earliestTimestamp)
+	}
+
+	priorHash, err := m.fetchBlockHash(m.ctx, new(big.Int).SetUint64(m.lastBlockNumber))
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch block hash for reorg check: %w", err)
+	}
+	if priorHash == m.lastBlockHash {
+		return m.fetchGames(m.ctx, blockHash, earliestTimestamp)
+	}
+
+	m.logger.Warn("Detected reorg", "lastBlockNumber", m.lastBlockNumber, "lastBlockHash", m.lastBlockHash, "newBlockHash", priorHash)
+	m.metrics.RecordReorgDetected()
+	fromBlockNumber := m.reorgRescanFrom(m.lastBlockNumber)
+	fromBlockHash, err := m.fetchBlockHash(m.ctx, new(big.Int).SetUint64(fromBlockNumber))
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch block hash for reorg rescan: %w", err)
+	}
+	return m.fetchGamesRange(m.ctx, fromBlockHash, blockHash, earliestTimestamp)
+}
+
+// subscribeNewGames subscribes to new game creation events, automatically
+// resubscribing with backoff on drops, similar to go-ethereum's typical use
+// of event.ResubscribeErr.
+func (m *gameMonitor) subscribeNewGames() (ethereum.Subscription, error) {
+	sub := event.ResubscribeErr(gameSubscriptionBackoff, func(ctx context.Context, lastErr error) (event.Subscription, error) {
+		if lastErr != nil {
+			m.logger.Warn("Resubscribing to new games after error", "err", lastErr)
+		}
+		return m.gameSubscriber.SubscribeNewGames(ctx, m.newGames)
+	})
+	return sub, nil
+}
+
+// onNewGame runs detect/forecast for a single game as soon as its creation
+// log arrives, rather than waiting for the next monitorInterval tick. It
+// goes through the same bounded, rate-limited dispatch path as a ticker
+// scan so subscription-driven games can't bypass the concurrency cap.
+func (m *gameMonitor) onNewGame(game types.GameMetadata) {
+	go m.runGame(game)
+}
+
 func (m *gameMonitor) loop() {
 	ticker := m.clock.NewTicker(m.monitorInterval)
 	defer ticker.Stop()
+
+	var subErrCh <-chan error
+	subscribed := false
+	if m.gameSubscriber != nil && m.mode != MonitorModePollingOnly {
+		sub, err := m.subscribeNewGames()
+		if err != nil {
+			m.logger.Error("Failed to subscribe to new games, falling back to polling only", "err", err)
+		} else {
+			defer sub.Unsubscribe()
+			subErrCh = sub.Err()
+			subscribed = true
+		}
+	}
+
 	for {
 		select {
 		case <-ticker.Ch():
+			// MonitorModeHybrid always runs the full-window reconciliation
+			// at full cadence, on top of whatever the subscription
+			// delivers. MonitorModeSubscriptionFirst instead prioritizes
+			// the subscription: while it's healthy, the ticker tick is a
+			// no-op, and it only takes over full polling once the
+			// subscription is gone, covering subscription gaps or
+			// reconnects.
+			if m.mode == MonitorModeSubscriptionFirst && subscribed {
+				m.logger.Debug("Skipping ticker scan while subscription is healthy")
+				continue
+			}
 			if err := m.monitorGames(); err != nil {
 				m.logger.Error("Failed to monitor games", "err", err)
 			}
+		case game := <-m.newGames:
+			m.onNewGame(game)
+		case err := <-subErrCh:
+			m.logger.Error("Game subscription error", "err", err)
+			subscribed = false
 		case <-m.done:
 			m.logger.Info("Stopping game monitor")
 			return
@@ -112,13 +308,17 @@ func (m *gameMonitor) loop() {
 }
 
 func (m *gameMonitor) StartMonitoring() {
-	// Setup the cancellation only if it's not already set.
-	// This prevents overwriting the context and cancel function
-	// if, for example, this function is called multiple times.
+	// Setup the cancellation and start the rate limiter only if they
+	// aren't already set up. This prevents overwriting the context and
+	// cancel function, or starting a second limiter loop, if, for
+	// example, this function is called multiple times.
 	if m.cancel == nil {
 		ctx, cancel := context.WithCancel(m.ctx)
 		m.ctx = ctx
 		m.cancel = cancel
+		if m.limiter != nil {
+			m.limiter.Start()
+		}
 	}
 	m.logger.Info("Starting game monitor")
 	go m.loop()
@@ -131,4 +331,8 @@ func (m *gameMonitor) StopMonitoring() {
 		m.cancel = nil
 	}
 	close(m.done)
+	if m.limiter != nil {
+		m.limiter.Stop()
+	}
+	m.metrics.Unregister()
 }
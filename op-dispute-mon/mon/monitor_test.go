@@ -0,0 +1,122 @@
+package mon
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum-optimism/optimism/op-challenger/game/types"
+	"github.com/ethereum-optimism/optimism/op-service/clock"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestMonitorForScan builds a gameMonitor with the given factory/block
+// fetchers wired in, and everything else set to an inert default, for
+// exercising fetchGamesForScan in isolation.
+func newTestMonitorForScan(t *testing.T, fetchGames FactoryGameFetcher, fetchGamesRange FactoryGameRangeFetcher, fetchBlockHash BlockHashFetcher) *gameMonitor {
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	return newGameMonitor(
+		ctx,
+		log.NewLogger(log.DiscardHandler()),
+		clock.SystemClock,
+		nil,
+		time.Second,
+		0,
+		0,
+		1,
+		MonitorModePollingOnly,
+		nil,
+		1,
+		0,
+		0,
+		func(ctx context.Context, games []types.GameMetadata) {},
+		func(ctx context.Context, games []types.GameMetadata) {},
+		fetchGames,
+		fetchGamesRange,
+		nil,
+		fetchBlockHash,
+	)
+}
+
+func TestFetchGamesForScan_FirstTickUsesFullWindow(t *testing.T) {
+	fullFetchCalled := false
+	fetchGames := func(ctx context.Context, blockHash common.Hash, earliestTimestamp uint64) ([]types.GameMetadata, error) {
+		fullFetchCalled = true
+		return nil, nil
+	}
+	fetchGamesRange := func(ctx context.Context, from, to common.Hash, earliestTimestamp uint64) ([]types.GameMetadata, error) {
+		t.Fatal("fetchGamesRange should not be called on the first tick")
+		return nil, nil
+	}
+
+	m := newTestMonitorForScan(t, fetchGames, fetchGamesRange, nil)
+
+	_, err := m.fetchGamesForScan(10, common.HexToHash("0xaa"))
+	require.NoError(t, err)
+	require.True(t, fullFetchCalled)
+}
+
+func TestFetchGamesForScan_NoReorgStillUsesFullWindow(t *testing.T) {
+	fullFetchCount := 0
+	fetchGames := func(ctx context.Context, blockHash common.Hash, earliestTimestamp uint64) ([]types.GameMetadata, error) {
+		fullFetchCount++
+		return nil, nil
+	}
+	rangeFetchCalled := false
+	fetchGamesRange := func(ctx context.Context, from, to common.Hash, earliestTimestamp uint64) ([]types.GameMetadata, error) {
+		rangeFetchCalled = true
+		return nil, nil
+	}
+	lastHash := common.HexToHash("0xaa")
+	fetchBlockHash := func(ctx context.Context, number *big.Int) (common.Hash, error) {
+		return lastHash, nil
+	}
+
+	m := newTestMonitorForScan(t, fetchGames, fetchGamesRange, fetchBlockHash)
+	m.haveLastScanned = true
+	m.lastBlockNumber = 9
+	m.lastBlockHash = lastHash
+
+	_, err := m.fetchGamesForScan(10, common.HexToHash("0xbb"))
+	require.NoError(t, err)
+	require.False(t, rangeFetchCalled, "fetchGamesRange must only be used for the reorg delta, not steady-state advances")
+	require.Equal(t, 1, fullFetchCount)
+}
+
+func TestFetchGamesForScan_ReorgUsesRangeFetch(t *testing.T) {
+	fetchGames := func(ctx context.Context, blockHash common.Hash, earliestTimestamp uint64) ([]types.GameMetadata, error) {
+		t.Fatal("fetchGames should not be called when a reorg is detected")
+		return nil, nil
+	}
+
+	rewoundHash := common.HexToHash("0xcc")
+	newPriorHash := common.HexToHash("0xdd")
+	var gotFrom, gotTo common.Hash
+	fetchGamesRange := func(ctx context.Context, from, to common.Hash, earliestTimestamp uint64) ([]types.GameMetadata, error) {
+		gotFrom, gotTo = from, to
+		return nil, nil
+	}
+	fetchBlockHash := func(ctx context.Context, number *big.Int) (common.Hash, error) {
+		if number.Uint64() == 9 {
+			// Differs from m.lastBlockHash below, signalling a reorg.
+			return newPriorHash, nil
+		}
+		return rewoundHash, nil
+	}
+
+	m := newTestMonitorForScan(t, fetchGames, fetchGamesRange, fetchBlockHash)
+	m.haveLastScanned = true
+	m.lastBlockNumber = 9
+	m.lastBlockHash = common.HexToHash("0xaa")
+	m.reorgRescanDepth = 1
+
+	to := common.HexToHash("0xbb")
+	_, err := m.fetchGamesForScan(10, to)
+	require.NoError(t, err)
+	require.Equal(t, rewoundHash, gotFrom)
+	require.Equal(t, to, gotTo)
+}
@@ -0,0 +1,126 @@
+package mon
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+)
+
+// ErrRateLimiterStopped is returned by Wait once the limiter has been
+// stopped, including to callers already queued for a permit.
+var ErrRateLimiterStopped = errors.New("rate limiter stopped")
+
+// permitRequest is a single request for a permit, queued on permitCh and
+// resolved on result by the limiter's dispatch goroutine.
+type permitRequest struct {
+	result chan error
+}
+
+// RateLimiter is a token-bucket limiter used to cap the rate of per-game RPC
+// calls the monitor issues against the L1 endpoint. A single goroutine owns
+// the bucket and gates requests arriving on permitCh, handing permits back
+// on a per-request result channel; callers cancel cleanly via ctx. It can be
+// Start()'d and Stop()'d repeatedly, e.g. across a StopMonitoring/
+// StartMonitoring cycle on the owning monitor.
+type RateLimiter struct {
+	opsPerSec float64
+	burst     int
+
+	permitCh chan permitRequest
+
+	mu   sync.Mutex
+	done chan struct{}
+}
+
+// NewRateLimiter creates a RateLimiter that allows opsPerSec permits per
+// second on average, with up to burst permits available immediately. The
+// bucket never refills above burst, so with a burst below 1 no permit is
+// ever granted.
+func NewRateLimiter(opsPerSec float64, burst int) *RateLimiter {
+	return &RateLimiter{
+		opsPerSec: opsPerSec,
+		burst:     burst,
+		permitCh:  make(chan permitRequest),
+	}
+}
+
+// Start begins refilling the token bucket, allocating a fresh done channel
+// so a prior Stop doesn't leave this run's loop observing an
+// already-closed channel. It must be called before Wait, and may be
+// called again after a Stop to restart the limiter.
+func (r *RateLimiter) Start() {
+	r.mu.Lock()
+	done := make(chan struct{})
+	r.done = done
+	r.mu.Unlock()
+	go r.loop(done)
+}
+
+// Stop shuts down the limiter's goroutine, failing any requests still
+// waiting for a permit with ErrRateLimiterStopped.
+func (r *RateLimiter) Stop() {
+	close(r.currentDone())
+}
+
+// currentDone returns the done channel for the most recent Start, so Wait
+// and Stop always observe the current run's channel rather than one from a
+// prior, already-stopped run.
+func (r *RateLimiter) currentDone() chan struct{} {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.done
+}
+
+// Wait blocks until a permit is available or ctx is cancelled.
+func (r *RateLimiter) Wait(ctx context.Context) error {
+	done := r.currentDone()
+	req := permitRequest{result: make(chan error, 1)}
+	select {
+	case r.permitCh <- req:
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-done:
+		return ErrRateLimiterStopped
+	}
+	select {
+	case err := <-req.result:
+		return err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (r *RateLimiter) loop(done chan struct{}) {
+	interval := time.Duration(float64(time.Second) / r.opsPerSec)
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	tokens := r.burst
+	var pending []permitRequest
+	for {
+		select {
+		case <-ticker.C:
+			if tokens < r.burst {
+				tokens++
+			}
+			for len(pending) > 0 && tokens > 0 {
+				pending[0].result <- nil
+				pending = pending[1:]
+				tokens--
+			}
+		case req := <-r.permitCh:
+			if tokens > 0 {
+				tokens--
+				req.result <- nil
+			} else {
+				pending = append(pending, req)
+			}
+		case <-done:
+			for _, req := range pending {
+				req.result <- ErrRateLimiterStopped
+			}
+			return
+		}
+	}
+}
@@ -0,0 +1,62 @@
+package mon
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRateLimiter_BurstThenBlocksUntilRefill(t *testing.T) {
+	limiter := NewRateLimiter(1, 2)
+	limiter.Start()
+	defer limiter.Stop()
+
+	ctx := context.Background()
+	require.NoError(t, limiter.Wait(ctx))
+	require.NoError(t, limiter.Wait(ctx))
+
+	shortCtx, cancel := context.WithTimeout(ctx, 50*time.Millisecond)
+	defer cancel()
+	err := limiter.Wait(shortCtx)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestRateLimiter_StopUnblocksWaiters(t *testing.T) {
+	limiter := NewRateLimiter(1, 0)
+	limiter.Start()
+
+	done := make(chan error, 1)
+	go func() {
+		done <- limiter.Wait(context.Background())
+	}()
+
+	// Give the waiter time to queue behind the empty bucket before
+	// stopping, rather than racing Stop against the goroutine above.
+	time.Sleep(20 * time.Millisecond)
+	limiter.Stop()
+
+	select {
+	case err := <-done:
+		require.ErrorIs(t, err, ErrRateLimiterStopped)
+	case <-time.After(time.Second):
+		t.Fatal("Wait did not unblock after Stop")
+	}
+}
+
+func TestRateLimiter_RestartsAfterStop(t *testing.T) {
+	limiter := NewRateLimiter(1e6, 1)
+	limiter.Start()
+
+	require.NoError(t, limiter.Wait(context.Background()))
+
+	limiter.Stop()
+	limiter.Start()
+	defer limiter.Stop()
+
+	// Without a fresh done channel per Start, Wait would still observe
+	// the first run's closed channel here and return ErrRateLimiterStopped
+	// forever, even though the limiter was just restarted.
+	require.NoError(t, limiter.Wait(context.Background()), "Wait must grant permits again after Start following a Stop")
+}
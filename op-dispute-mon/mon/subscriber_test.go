@@ -0,0 +1,200 @@
+package mon
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/ethereum-optimism/optimism/op-challenger/game/types"
+	"github.com/ethereum-optimism/optimism/op-service/clock"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeSubscription is a minimal ethereum.Subscription whose Err() channel
+// the test controls directly.
+type fakeSubscription struct {
+	errCh chan error
+}
+
+func newFakeSubscription() *fakeSubscription {
+	return &fakeSubscription{errCh: make(chan error, 1)}
+}
+
+func (f *fakeSubscription) Unsubscribe() {}
+
+func (f *fakeSubscription) Err() <-chan error {
+	return f.errCh
+}
+
+// fakeGameEventSubscriber records every SubscribeNewGames call and, when
+// failFirst is set, fails the first call before succeeding on subsequent
+// ones, to exercise subscribeNewGames' resubscribe-on-error path.
+type fakeGameEventSubscriber struct {
+	failFirst bool
+
+	mu    sync.Mutex
+	calls int
+}
+
+func (f *fakeGameEventSubscriber) SubscribeNewGames(ctx context.Context, sink chan<- types.GameMetadata) (ethereum.Subscription, error) {
+	f.mu.Lock()
+	f.calls++
+	calls := f.calls
+	f.mu.Unlock()
+
+	if f.failFirst && calls == 1 {
+		return nil, errors.New("boom")
+	}
+	return newFakeSubscription(), nil
+}
+
+func (f *fakeGameEventSubscriber) callCount() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.calls
+}
+
+func TestSubscribeNewGames_ResubscribesAfterError(t *testing.T) {
+	subscriber := &fakeGameEventSubscriber{failFirst: true}
+	m := newTestMonitorForLoop(t, MonitorModeSubscriptionFirst, subscriber, time.Hour)
+
+	sub, err := m.subscribeNewGames()
+	require.NoError(t, err)
+	defer sub.Unsubscribe()
+
+	require.Eventually(t, func() bool {
+		return subscriber.callCount() >= 2
+	}, 3*time.Second, 10*time.Millisecond, "subscribeNewGames should retry after the first SubscribeNewGames call fails")
+}
+
+func TestLoop_DispatchesOnNewGameArrival(t *testing.T) {
+	detected := make(chan types.GameMetadata, 1)
+	detect := func(ctx context.Context, games []types.GameMetadata) {
+		if len(games) == 1 {
+			select {
+			case detected <- games[0]:
+			default:
+			}
+		}
+	}
+
+	m := newTestMonitorForDispatch(t, 1, detect)
+	m.monitorInterval = time.Hour // keep the ticker out of the way
+	m.StartMonitoring()
+	defer m.StopMonitoring()
+
+	game := types.GameMetadata{}
+	m.newGames <- game
+
+	select {
+	case got := <-detected:
+		require.Equal(t, game, got)
+	case <-time.After(time.Second):
+		t.Fatal("loop() did not dispatch detect/forecast for a newGames arrival")
+	}
+}
+
+func TestLoop_HybridAlwaysRunsTickerRegardlessOfSubscription(t *testing.T) {
+	subscriber := &fakeGameEventSubscriber{}
+	m, blockFetchCount := newTestMonitorForLoopWithFetchers(t, MonitorModeHybrid, subscriber, 20*time.Millisecond)
+
+	m.StartMonitoring()
+	defer m.StopMonitoring()
+
+	require.Eventually(t, func() bool {
+		return atomic.LoadInt32(blockFetchCount) > 0
+	}, time.Second, 10*time.Millisecond, "hybrid mode must keep running the ticker scan even while the subscription is healthy")
+}
+
+func TestLoop_SubscriptionFirstSkipsTickerWhileSubscriptionHealthy(t *testing.T) {
+	subscriber := &fakeGameEventSubscriber{}
+	m, blockFetchCount := newTestMonitorForLoopWithFetchers(t, MonitorModeSubscriptionFirst, subscriber, 20*time.Millisecond)
+
+	m.StartMonitoring()
+	defer m.StopMonitoring()
+
+	// Give several ticks a chance to fire; none should reach
+	// fetchBlockNumber while the subscription stays healthy.
+	time.Sleep(150 * time.Millisecond)
+	require.Equal(t, int32(0), atomic.LoadInt32(blockFetchCount), "subscription-first mode should skip ticker scans while the subscription is healthy")
+}
+
+// newTestMonitorForLoop builds a gameMonitor wired with the given
+// subscriber and monitorInterval, for exercising subscribeNewGames/loop()
+// directly. It has no block/game fetchers wired in and is only suitable
+// for tests that don't invoke monitorGames().
+func newTestMonitorForLoop(t *testing.T, mode MonitorMode, subscriber GameEventSubscriber, monitorInterval time.Duration) *gameMonitor {
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	return newGameMonitor(
+		ctx,
+		log.NewLogger(log.DiscardHandler()),
+		clock.SystemClock,
+		nil,
+		monitorInterval,
+		0,
+		0,
+		0,
+		mode,
+		subscriber,
+		1,
+		0,
+		0,
+		func(ctx context.Context, games []types.GameMetadata) {},
+		func(ctx context.Context, games []types.GameMetadata) {},
+		nil,
+		nil,
+		nil,
+		nil,
+	)
+}
+
+// newTestMonitorForLoopWithFetchers is like newTestMonitorForLoop but wires
+// in trivial block/game fetchers so monitorGames() can run end-to-end, and
+// returns a counter of how many times fetchBlockNumber (i.e. a ticker-
+// driven scan) has fired.
+func newTestMonitorForLoopWithFetchers(t *testing.T, mode MonitorMode, subscriber GameEventSubscriber, monitorInterval time.Duration) (*gameMonitor, *int32) {
+	var blockFetchCount int32
+	fetchBlockNumber := func(ctx context.Context) (uint64, error) {
+		return uint64(atomic.AddInt32(&blockFetchCount, 1)), nil
+	}
+	fetchBlockHash := func(ctx context.Context, number *big.Int) (common.Hash, error) {
+		return common.BigToHash(number), nil
+	}
+	fetchGames := func(ctx context.Context, blockHash common.Hash, earliestTimestamp uint64) ([]types.GameMetadata, error) {
+		return nil, nil
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	m := newGameMonitor(
+		ctx,
+		log.NewLogger(log.DiscardHandler()),
+		clock.SystemClock,
+		nil,
+		monitorInterval,
+		0,
+		0,
+		0,
+		mode,
+		subscriber,
+		1,
+		0,
+		0,
+		func(ctx context.Context, games []types.GameMetadata) {},
+		func(ctx context.Context, games []types.GameMetadata) {},
+		fetchGames,
+		nil,
+		fetchBlockNumber,
+		fetchBlockHash,
+	)
+	return m, &blockFetchCount
+}